@@ -2,7 +2,9 @@ package aws
 
 import (
 	"context"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
@@ -26,6 +28,12 @@ func tableAwsBackupRecoveryPoint(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listAwsBackupVaults,
 			Hydrate:       listAwsBackupRecoveryPoints,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "backup_plan_id", Require: plugin.Optional},
+				{Name: "resource_arn", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "creation_date", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
 		},
 		GetMatrixItem: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -115,16 +123,46 @@ func tableAwsBackupRecoveryPoint(_ context.Context) *plugin.Table {
 				Description: "An object containing DeleteAt and MoveToColdStorageAt timestamps.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "days_until_cold_storage",
+				Description: "The number of days remaining until the recovery point transitions to cold storage, per calculated_lifecycle. Negative once the transition date has passed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(recoveryPointDaysUntilColdStorage),
+			},
+			{
+				Name:        "days_until_delete",
+				Description: "The number of days remaining until the recovery point expires, per calculated_lifecycle. Negative once the expiration date has passed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(recoveryPointDaysUntilDelete),
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The lifecycle state of the recovery point, one of warm, cold, expiring_soon or past_due, derived from calculated_lifecycle and storage_class.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(recoveryPointLifecycleState),
+			},
 			{
 				Name:        "created_by",
 				Description: "Contains identifying information about the creation of a recovery point, including the BackupPlanArn, BackupPlanId, BackupPlanVersion, and BackupRuleId of the backup plan used to create it.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "backup_plan_id",
+				Description: "Uniquely identifies a backup plan used to create the recovery point.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.BackupPlanId"),
+			},
 			{
 				Name:        "lifecycle",
 				Description: "The lifecycle defines when a protected resource is transitioned to cold storage and when it expires.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the recovery point.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRecoveryPointTags,
+			},
 
 			// Steampipe standard columns
 			{
@@ -139,6 +177,13 @@ func tableAwsBackupRecoveryPoint(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ResourceArn").Transform(arnToAkas),
 			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRecoveryPointTags,
+				Transform:   transform.From(backupRecoveryPointTurbotTags),
+			},
 		}),
 	}
 }
@@ -155,8 +200,43 @@ func listAwsBackupRecoveryPoints(ctx context.Context, d *plugin.QueryData, h *pl
 		return nil, err
 	}
 
+	input := &backup.ListRecoveryPointsByBackupVaultInput{
+		BackupVaultName: vault.BackupVaultName,
+	}
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["backup_plan_id"] != nil {
+		input.ByBackupPlanId = aws.String(equalQuals["backup_plan_id"].GetStringValue())
+	}
+	if equalQuals["resource_arn"] != nil {
+		input.ByResourceArn = aws.String(equalQuals["resource_arn"].GetStringValue())
+	}
+	if equalQuals["resource_type"] != nil {
+		input.ByResourceType = aws.String(equalQuals["resource_type"].GetStringValue())
+	}
+	if d.Quals["creation_date"] != nil {
+		for _, q := range d.Quals["creation_date"].Quals {
+			createdAt := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">":
+				input.ByCreatedAfter = aws.Time(createdAt)
+			case ">=":
+				// ByCreatedAfter is an exclusive bound ("created after" the timestamp), so a plain
+				// >= would silently drop a recovery point created exactly at the boundary. Nudge it
+				// back by the smallest representable duration to make the bound inclusive.
+				input.ByCreatedAfter = aws.Time(createdAt.Add(-time.Nanosecond))
+			case "<":
+				input.ByCreatedBefore = aws.Time(createdAt)
+			case "<=":
+				// Same reasoning as ">=" above, but nudging forward since ByCreatedBefore is
+				// exclusive of the timestamp itself.
+				input.ByCreatedBefore = aws.Time(createdAt.Add(time.Nanosecond))
+			}
+		}
+	}
+
 	err = svc.ListRecoveryPointsByBackupVaultPages(
-		&backup.ListRecoveryPointsByBackupVaultInput{BackupVaultName: vault.BackupVaultName},
+		input,
 		func(page *backup.ListRecoveryPointsByBackupVaultOutput, lastPage bool) bool {
 			for _, point := range page.RecoveryPoints {
 				d.StreamListItem(ctx, point)
@@ -201,6 +281,47 @@ func getAwsBackupRecoveryPoint(ctx context.Context, d *plugin.QueryData, h *plug
 	return detail, nil
 }
 
+func getAwsBackupRecoveryPointTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAwsBackupRecoveryPointTags")
+
+	var recoveryPointArn string
+	switch item := h.Item.(type) {
+	case *backup.RecoveryPointByBackupVault:
+		recoveryPointArn = *item.RecoveryPointArn
+	case *backup.RecoveryPointByResource:
+		recoveryPointArn = *item.RecoveryPointArn
+	case *backup.DescribeRecoveryPointOutput:
+		recoveryPointArn = *item.RecoveryPointArn
+	}
+
+	// Create session
+	svc, err := BackupService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]*string)
+	err = svc.ListTagsPages(
+		&backup.ListTagsInput{ResourceArn: aws.String(recoveryPointArn)},
+		func(page *backup.ListTagsOutput, lastPage bool) bool {
+			for k, v := range page.Tags {
+				tags[k] = v
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAwsBackupRecoveryPointTags", "ListTags error", err)
+		return nil, err
+	}
+
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	return tags, nil
+}
+
 //// TRANSFORM FUNCTION
 
 func recoveryPointTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -210,8 +331,88 @@ func recoveryPointTitle(_ context.Context, d *transform.TransformData) (interfac
 		arn = *item.RecoveryPointArn
 	case *backup.RecoveryPointByBackupVault:
 		arn = *item.RecoveryPointArn
+	case *backup.RecoveryPointByResource:
+		arn = *item.RecoveryPointArn
 	}
 
 	title := strings.Split(arn, "/")[1]
 	return title, nil
+}
+
+// recoveryPointCalculatedLifecycle pulls the CalculatedLifecycle and StorageClass fields out of
+// whichever recovery point shape the column's hydrate returned, so the lifecycle transforms below
+// work the same whether the data came from the list or the get hydrate.
+func recoveryPointCalculatedLifecycle(item interface{}) (*backup.CalculatedLifecycle, string) {
+	switch r := item.(type) {
+	case *backup.RecoveryPointByBackupVault:
+		return r.CalculatedLifecycle, aws.StringValue(r.StorageClass)
+	case *backup.DescribeRecoveryPointOutput:
+		return r.CalculatedLifecycle, aws.StringValue(r.StorageClass)
+	}
+	return nil, ""
+}
+
+// expiringSoonWindow is the lookahead used by lifecycle_state to flag a recovery point whose
+// deletion or cold storage transition is coming up, so it reads "expiring_soon" ahead of the
+// actual date rather than only once the date has passed.
+const expiringSoonWindow = 30 * 24 * time.Hour
+
+// daysUntil floors the duration to the given time to whole days, so the sign flips to negative
+// the moment the timestamp passes rather than only once a full day has elapsed.
+func daysUntil(at time.Time) int64 {
+	return int64(math.Floor(time.Until(at).Hours() / 24))
+}
+
+func recoveryPointDaysUntilColdStorage(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	lifecycle, _ := recoveryPointCalculatedLifecycle(d.HydrateItem)
+	if lifecycle == nil || lifecycle.MoveToColdStorageAt == nil {
+		return nil, nil
+	}
+	return daysUntil(*lifecycle.MoveToColdStorageAt), nil
+}
+
+func recoveryPointDaysUntilDelete(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	lifecycle, _ := recoveryPointCalculatedLifecycle(d.HydrateItem)
+	if lifecycle == nil || lifecycle.DeleteAt == nil {
+		return nil, nil
+	}
+	return daysUntil(*lifecycle.DeleteAt), nil
+}
+
+func recoveryPointLifecycleState(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	lifecycle, storageClass := recoveryPointCalculatedLifecycle(d.HydrateItem)
+	if lifecycle == nil {
+		return nil, nil
+	}
+
+	now := time.Now()
+	if lifecycle.DeleteAt != nil && now.After(*lifecycle.DeleteAt) {
+		return "past_due", nil
+	}
+
+	nearing := func(at *time.Time) bool {
+		return at != nil && at.After(now) && at.Sub(now) <= expiringSoonWindow
+	}
+	if nearing(lifecycle.DeleteAt) || nearing(lifecycle.MoveToColdStorageAt) {
+		return "expiring_soon", nil
+	}
+
+	if strings.EqualFold(storageClass, "COLD") {
+		return "cold", nil
+	}
+	return "warm", nil
+}
+
+func backupRecoveryPointTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.HydrateItem.(map[string]*string)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for key, value := range tags {
+		turbotTagsMap[key] = *value
+	}
+
+	return turbotTagsMap, nil
 }
\ No newline at end of file
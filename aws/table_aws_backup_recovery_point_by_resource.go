@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBackupRecoveryPointByResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_backup_recovery_point_by_resource",
+		Description: "AWS Backup Recovery Point by Resource",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("resource_arn"),
+			Hydrate:    listAwsBackupRecoveryPointsByResource,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "resource_arn",
+				Description: "An ARN that uniquely identifies the Amazon Web Services resource that the recovery point protects.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("resource_arn"),
+			},
+			{
+				Name:        "recovery_point_arn",
+				Description: "An ARN that uniquely identifies a recovery point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "A status code specifying the state of the recovery point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backup_vault_name",
+				Description: "The name of a logical container where backups are stored.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backup_size_bytes",
+				Description: "The size, in bytes, of a backup.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time that a recovery point is created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "encryption_key_arn",
+				Description: "The server-side encryption key used to protect your backups.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// DescribeRecoveryPoint columns, shared with aws_backup_recovery_point
+			{
+				Name:        "backup_vault_arn",
+				Description: "An ARN that uniquely identifies a backup vault.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+			{
+				Name:        "completion_date",
+				Description: "The date and time that a job to create a recovery point is completed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+			{
+				Name:        "iam_role_arn",
+				Description: "Specifies the IAM role ARN used to create the target recovery point.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+			{
+				Name:        "is_encrypted",
+				Description: "A Boolean value that is returned as TRUE if the specified recovery point is encrypted, or FALSE if the recovery point is not encrypted.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+			{
+				Name:        "last_restore_time",
+				Description: "The date and time that a recovery point was last restored.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+			{
+				Name:        "source_backup_vault_arn",
+				Description: "An Amazon Resource Name (ARN) that uniquely identifies the source vault where the resource was originally backed up in.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+			{
+				Name:        "status_message",
+				Description: "A status message explaining the reason for the recovery point deletion failure.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+			{
+				Name:        "storage_class",
+				Description: "Specifies the storage class of the recovery point. Valid values are WARM or COLD.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+			{
+				Name:        "calculated_lifecycle",
+				Description: "An object containing DeleteAt and MoveToColdStorageAt timestamps.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+			{
+				Name:        "created_by",
+				Description: "Contains identifying information about the creation of a recovery point, including the BackupPlanArn, BackupPlanId, BackupPlanVersion, and BackupRuleId of the backup plan used to create it.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+			{
+				Name:        "lifecycle",
+				Description: "The lifecycle defines when a protected resource is transitioned to cold storage and when it expires.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRecoveryPoint,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(recoveryPointTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("resource_arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsBackupRecoveryPointsByResource(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listAwsBackupRecoveryPointsByResource")
+	resourceArn := d.KeyColumnQuals["resource_arn"].GetStringValue()
+
+	// Create session
+	svc, err := BackupService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.ListRecoveryPointsByResourcePages(
+		&backup.ListRecoveryPointsByResourceInput{ResourceArn: aws.String(resourceArn)},
+		func(page *backup.ListRecoveryPointsByResourceOutput, lastPage bool) bool {
+			for _, point := range page.RecoveryPoints {
+				d.StreamListItem(ctx, point)
+			}
+			return !lastPage
+		},
+	)
+	return nil, err
+}
@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBackupRestoreTestingPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_backup_restore_testing_plan",
+		Description: "AWS Backup Restore Testing Plan",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("restore_testing_plan_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"ResourceNotFoundException"}),
+			Hydrate:           getAwsBackupRestoreTestingPlan,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsBackupRestoreTestingPlans,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "restore_testing_plan_name",
+				Description: "The unique name of the restore testing plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "restore_testing_plan_arn",
+				Description: "An ARN that uniquely identifies the restore testing plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the restore testing plan was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_execution_time",
+				Description: "The last time a restore test was run with the restore testing plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_update_time",
+				Description: "The date and time that the restore testing plan was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "schedule_expression",
+				Description: "A CRON expression, in the specified timezone, that defines when a restore testing plan is run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "schedule_expression_timezone",
+				Description: "The timezone in which the restore testing plan's schedule expression is calculated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_window_hours",
+				Description: "Defines the start time of a restore window, the minimum time to restore a recovery point before the job is considered to have failed, not the timeout of the restore job itself.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsBackupRestoreTestingPlan,
+			},
+			{
+				Name:        "recovery_point_selection",
+				Description: "The specified criteria to assign a set of resources, such as recovery point types or backup vaults.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRestoreTestingPlan,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestoreTestingPlanName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RestoreTestingPlanArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsBackupRestoreTestingPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listAwsBackupRestoreTestingPlans")
+
+	// Create session
+	svc, err := BackupService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.ListRestoreTestingPlansPages(
+		&backup.ListRestoreTestingPlansInput{},
+		func(page *backup.ListRestoreTestingPlansOutput, lastPage bool) bool {
+			for _, plan := range page.RestoreTestingPlans {
+				d.StreamListItem(ctx, plan)
+			}
+			return !lastPage
+		},
+	)
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getAwsBackupRestoreTestingPlan(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAwsBackupRestoreTestingPlan")
+
+	var restoreTestingPlanName string
+	if h.Item != nil {
+		restoreTestingPlanName = *h.Item.(*backup.RestoreTestingPlanForList).RestoreTestingPlanName
+	} else {
+		restoreTestingPlanName = d.KeyColumnQuals["restore_testing_plan_name"].GetStringValue()
+	}
+
+	// Create session
+	svc, err := BackupService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &backup.GetRestoreTestingPlanInput{
+		RestoreTestingPlanName: aws.String(restoreTestingPlanName),
+	}
+
+	detail, err := svc.GetRestoreTestingPlan(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAwsBackupRestoreTestingPlan", "GetRestoreTestingPlan error", err)
+		return nil, err
+	}
+
+	return detail.RestoreTestingPlan, nil
+}
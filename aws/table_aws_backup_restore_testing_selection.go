@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBackupRestoreTestingSelection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_backup_restore_testing_selection",
+		Description: "AWS Backup Restore Testing Selection",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.AllColumns([]string{"restore_testing_plan_name", "restore_testing_selection_name"}),
+			ShouldIgnoreError: isNotFoundError([]string{"ResourceNotFoundException"}),
+			Hydrate:           getAwsBackupRestoreTestingSelection,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsBackupRestoreTestingPlans,
+			Hydrate:       listAwsBackupRestoreTestingSelections,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "restore_testing_selection_name",
+				Description: "The unique name of the restore testing selection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "restore_testing_plan_name",
+				Description: "The unique name of the restore testing plan that this selection belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the restore testing selection was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "iam_role_arn",
+				Description: "The IAM role ARN that Backup uses to create the target resource during a restore test job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protected_resource_type",
+				Description: "The type of Amazon Web Services resource included in the restore testing selection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "validation_window_hours",
+				Description: "The amount of hours available to run a validation test before the restore testing job is canceled.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "protected_resource_arns",
+				Description: "The specified list of ARNs of resources to assign to the restore testing selection.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRestoreTestingSelection,
+			},
+			{
+				Name:        "protected_resource_conditions",
+				Description: "The conditions, such as StringEquals and StringNotEquals, used to filter resources included or excluded from the restore testing selection.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRestoreTestingSelection,
+			},
+			{
+				Name:        "restore_metadata_overrides",
+				Description: "Overrides to a restore testing plan's default settings for a protected resource type.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRestoreTestingSelection,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestoreTestingSelectionName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsBackupRestoreTestingSelections(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listAwsBackupRestoreTestingSelections")
+	plan := h.Item.(*backup.RestoreTestingPlanForList)
+
+	// Create session
+	svc, err := BackupService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.ListRestoreTestingSelectionsPages(
+		&backup.ListRestoreTestingSelectionsInput{RestoreTestingPlanName: plan.RestoreTestingPlanName},
+		func(page *backup.ListRestoreTestingSelectionsOutput, lastPage bool) bool {
+			for _, selection := range page.RestoreTestingSelections {
+				d.StreamListItem(ctx, selection)
+			}
+			return !lastPage
+		},
+	)
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getAwsBackupRestoreTestingSelection(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAwsBackupRestoreTestingSelection")
+
+	var restoreTestingPlanName, restoreTestingSelectionName string
+	if h.Item != nil {
+		item := h.Item.(*backup.RestoreTestingSelectionForList)
+		restoreTestingPlanName = *item.RestoreTestingPlanName
+		restoreTestingSelectionName = *item.RestoreTestingSelectionName
+	} else {
+		restoreTestingPlanName = d.KeyColumnQuals["restore_testing_plan_name"].GetStringValue()
+		restoreTestingSelectionName = d.KeyColumnQuals["restore_testing_selection_name"].GetStringValue()
+	}
+
+	// Create session
+	svc, err := BackupService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &backup.GetRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(restoreTestingPlanName),
+		RestoreTestingSelectionName: aws.String(restoreTestingSelectionName),
+	}
+
+	detail, err := svc.GetRestoreTestingSelection(params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAwsBackupRestoreTestingSelection", "GetRestoreTestingSelection error", err)
+		return nil, err
+	}
+
+	return detail.RestoreTestingSelection, nil
+}